@@ -0,0 +1,120 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package version
+
+import (
+	"runtime/debug"
+
+	"github.com/prometheus/client_golang/prometheus"
+	flag "github.com/spf13/pflag"
+	ctrlrtmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	buildInfoMetricName = "ack_prometheusservice_controller_build_info"
+	runtimeModulePath   = "github.com/aws-controllers-k8s/runtime"
+	awsSDKModulePath    = "github.com/aws/aws-sdk-go"
+	// flagAWSRegion must match the (unexported) flagAWSRegion constant in the
+	// ACK runtime's pkg/config/config.go. If the runtime renames the flag, the
+	// region label of the build info metric will be empty.
+	flagAWSRegion = "aws-region"
+)
+
+var buildInfoDesc = prometheus.NewDesc(
+	buildInfoMetricName,
+	"A metric with a constant '1' value labeled by the controller's version, "+
+		"git commit and build date, the ACK runtime and aws-sdk-go versions, "+
+		"and the AWS region.",
+	[]string{
+		"version", "git_commit", "build_date",
+		"runtime_version", "aws_sdk_go_version", "region",
+	},
+	nil,
+)
+
+// The controller's main.go is generated and has no hook for registering
+// service-specific metrics, so the build info collector is registered when
+// this package is imported.
+func init() {
+	var deps []*debug.Module
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		deps = bi.Deps
+	}
+	ctrlrtmetrics.Registry.MustRegister(
+		NewBuildInfoCollector(flag.CommandLine, deps),
+	)
+}
+
+// NewBuildInfoCollector returns a prometheus.Collector exporting the build
+// information of the running controller as a constant info metric. Module
+// versions are looked up in the supplied build dependencies and the region
+// is read from the supplied flag set at collection time, since flags have
+// not been parsed yet when the collector is registered.
+func NewBuildInfoCollector(
+	flags *flag.FlagSet,
+	deps []*debug.Module,
+) prometheus.Collector {
+	return &buildInfoCollector{
+		flags:          flags,
+		runtimeVersion: moduleVersion(deps, runtimeModulePath),
+		awsSDKVersion:  moduleVersion(deps, awsSDKModulePath),
+	}
+}
+
+type buildInfoCollector struct {
+	flags          *flag.FlagSet
+	runtimeVersion string
+	awsSDKVersion  string
+}
+
+// Describe implements prometheus.Collector
+func (c *buildInfoCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- buildInfoDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *buildInfoCollector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(
+		buildInfoDesc, prometheus.GaugeValue, 1,
+		GitVersion, GitCommit, BuildDate,
+		c.runtimeVersion, c.awsSDKVersion, c.region(),
+	)
+}
+
+// region returns the AWS region the controller was configured with through
+// the --aws-region flag (which defaults to the AWS_REGION environment
+// variable), or an empty string if the flag is not bound.
+func (c *buildInfoCollector) region() string {
+	f := c.flags.Lookup(flagAWSRegion)
+	if f == nil {
+		return ""
+	}
+	return f.Value.String()
+}
+
+// moduleVersion returns the version of the module with the supplied path
+// among deps, honouring replace directives, or an empty string if the module
+// is not found.
+func moduleVersion(deps []*debug.Module, path string) string {
+	for _, dep := range deps {
+		if dep.Path != path {
+			continue
+		}
+		if dep.Replace != nil {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return ""
+}
@@ -0,0 +1,87 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package version
+
+import (
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	flag "github.com/spf13/pflag"
+)
+
+func TestBuildInfoCollector(t *testing.T) {
+	GitVersion, GitCommit, BuildDate = "v1.2.3", "abc123", "2022-06-09T17:20:43Z"
+	defer func() { GitVersion, GitCommit, BuildDate = "", "", "" }()
+
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.String(flagAWSRegion, "", "")
+	if err := flags.Parse([]string{"--aws-region", "us-west-2"}); err != nil {
+		t.Fatal(err)
+	}
+	deps := []*debug.Module{
+		{Path: runtimeModulePath, Version: "v0.18.4"},
+		{Path: awsSDKModulePath, Version: "v1.42.0"},
+	}
+
+	reg := prometheus.NewPedanticRegistry()
+	reg.MustRegister(NewBuildInfoCollector(flags, deps))
+
+	expected := `
+# HELP ack_prometheusservice_controller_build_info A metric with a constant '1' value labeled by the controller's version, git commit and build date, the ACK runtime and aws-sdk-go versions, and the AWS region.
+# TYPE ack_prometheusservice_controller_build_info gauge
+ack_prometheusservice_controller_build_info{aws_sdk_go_version="v1.42.0",build_date="2022-06-09T17:20:43Z",git_commit="abc123",region="us-west-2",runtime_version="v0.18.4",version="v1.2.3"} 1
+`
+	if err := testutil.GatherAndCompare(
+		reg, strings.NewReader(expected), buildInfoMetricName,
+	); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildInfoCollectorRegionFlagUnbound(t *testing.T) {
+	c := NewBuildInfoCollector(flag.NewFlagSet("test", flag.ContinueOnError), nil)
+	if got := c.(*buildInfoCollector).region(); got != "" {
+		t.Errorf("expected empty region, got %q", got)
+	}
+}
+
+func TestModuleVersion(t *testing.T) {
+	deps := []*debug.Module{
+		{Path: runtimeModulePath, Version: "v0.18.4"},
+		{
+			Path:    awsSDKModulePath,
+			Version: "v1.42.0",
+			Replace: &debug.Module{Path: "example.com/aws-sdk-go", Version: "v1.42.1-fork"},
+		},
+	}
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{"direct", runtimeModulePath, "v0.18.4"},
+		{"replaced", awsSDKModulePath, "v1.42.1-fork"},
+		{"missing", "example.com/missing", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := moduleVersion(deps, tt.path); got != tt.want {
+				t.Errorf("moduleVersion(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}